@@ -11,3 +11,37 @@ func max(num1 int, num2 int) int {
 	
 	return result
 }
+
+// PaginationParams describes a window into an ordered collection.
+type PaginationParams struct {
+	Limit  int
+	Offset int
+}
+
+// Paginate returns the window of items described by params.  A negative
+// offset is treated as zero and offsets past the end yield an empty slice.  A
+// zero or negative limit also yields an empty slice, and a limit larger than
+// the remaining items is clamped to what is left.  The result shares its
+// backing array with items but has its capacity capped, so appending to it
+// copies rather than overwriting the caller's collection.
+func Paginate[T any](items []T, params PaginationParams) []T {
+	start := params.Offset
+	if start < 0 {
+		start = 0
+	}
+	if start > len(items) {
+		start = len(items)
+	}
+
+	remaining := len(items) - start
+	limit := params.Limit
+	if limit < 0 {
+		limit = 0
+	}
+	if limit > remaining {
+		limit = remaining
+	}
+	end := start + limit
+
+	return items[start:end:end]
+}
@@ -1,2 +1,85 @@
 package util
 
+import (
+	"math"
+	"testing"
+)
+
+func TestPaginateOffsetPastEnd(t *testing.T) {
+	items := []int{1, 2, 3}
+
+	got := Paginate(items, PaginationParams{Limit: 10, Offset: 5})
+
+	if len(got) != 0 {
+		t.Errorf("expected no items, got %v", got)
+	}
+}
+
+func TestPaginateLimitExceedsRemaining(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+
+	got := Paginate(items, PaginationParams{Limit: 10, Offset: 3})
+
+	if len(got) != 2 || got[0] != 4 || got[1] != 5 {
+		t.Errorf("expected [4 5], got %v", got)
+	}
+}
+
+func TestPaginateZeroLengthInput(t *testing.T) {
+	var items []string
+
+	got := Paginate(items, PaginationParams{Limit: 10, Offset: 0})
+
+	if len(got) != 0 {
+		t.Errorf("expected no items, got %v", got)
+	}
+}
+
+func TestPaginateHugeLimitWithOffset(t *testing.T) {
+	items := []int{1, 2, 3}
+
+	got := Paginate(items, PaginationParams{Limit: math.MaxInt, Offset: 1})
+
+	if len(got) != 2 || got[0] != 2 || got[1] != 3 {
+		t.Errorf("expected [2 3], got %v", got)
+	}
+}
+
+func TestPaginateAppendDoesNotOverwriteItems(t *testing.T) {
+	items := []int{1, 2, 3, 4}
+
+	_ = append(Paginate(items, PaginationParams{Limit: 2}), 99)
+
+	if items[2] != 3 {
+		t.Errorf("expected items to be unchanged, got %v", items)
+	}
+}
+
+func TestPaginateNonPositiveLimitAndNegativeOffset(t *testing.T) {
+	items := []int{1, 2, 3}
+
+	tests := []struct {
+		name   string
+		params PaginationParams
+		want   []int
+	}{
+		{"zero limit", PaginationParams{Limit: 0, Offset: 0}, []int{}},
+		{"negative limit", PaginationParams{Limit: -1, Offset: 0}, []int{}},
+		{"negative offset", PaginationParams{Limit: 2, Offset: -5}, []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Paginate(items, tt.params)
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("expected %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+}